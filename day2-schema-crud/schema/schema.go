@@ -0,0 +1,93 @@
+/*
+schema.go 负责把 Go 结构体翻译成数据库可以理解的表结构。
+  - 通过 reflect 遍历结构体的导出字段；
+  - 为每个字段推导出对应的 SQL 列名与 SQL 类型；
+  - 产出一个 Schema，供 session 构建 CREATE TABLE / INSERT / SELECT 等语句时使用。
+*/
+package schema
+
+import "reflect"
+
+// Field 表示数据库表的一列，对应结构体的一个导出字段。
+type Field struct {
+	Name string // 列名，默认等于字段名
+	Type string // 列的 SQL 类型，如 "text"、"integer"
+}
+
+// Schema 是某个结构体对应的表结构。
+type Schema struct {
+	Model      interface{}       // 被解析的结构体实例（指针），供后续 reflect 取值使用
+	Name       string            // 表名，默认等于结构体名
+	Fields     []*Field          // 所有列
+	FieldNames []string          // 所有列名，顺序与 Fields 一致
+	fieldMap   map[string]*Field // 列名 -> Field，便于按名查找
+}
+
+// GetField 根据列名查找对应的 Field，不存在时返回 nil。
+func (schema *Schema) GetField(name string) *Field {
+	return schema.fieldMap[name]
+}
+
+// RecordValues 按 FieldNames 的顺序，取出 dest 对应字段的值，供 INSERT 语句使用。
+func (schema *Schema) RecordValues(dest interface{}) []interface{} {
+	destValue := reflect.Indirect(reflect.ValueOf(dest))
+	var fieldValues []interface{}
+	for _, name := range schema.FieldNames {
+		fieldValues = append(fieldValues, destValue.FieldByName(name).Interface())
+	}
+	return fieldValues
+}
+
+// dataTypeOf 把一个 Go 值映射成 SQL 类型。
+// 目前只覆盖 sqlite3 常用的几种类型，后续会被可插拔的 dialect 取代。
+func dataTypeOf(typ reflect.Value) string {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "real"
+	case reflect.String:
+		return "text"
+	case reflect.Bool:
+		return "bool"
+	}
+	panic("unsupported type: " + typ.Type().Name())
+}
+
+// Parse 接受一个结构体指针 dest，解析出它对应的 Schema。
+// 只处理导出字段（首字母大写）；匿名的结构体字段会被展开，它的导出字段视作当前表的列。
+func Parse(dest interface{}) *Schema {
+	modelType := reflect.TypeOf(dest).Elem()
+
+	schema := &Schema{
+		Model:    dest,
+		Name:     modelType.Name(),
+		fieldMap: make(map[string]*Field),
+	}
+
+	addFields(schema, modelType)
+
+	return schema
+}
+
+// addFields 把 typ 的导出字段追加到 schema 中；遇到匿名结构体字段会递归展开。
+func addFields(schema *Schema, typ reflect.Type) {
+	for i := 0; i < typ.NumField(); i++ {
+		p := typ.Field(i)
+		if !p.IsExported() {
+			continue
+		}
+		if p.Anonymous && p.Type.Kind() == reflect.Struct {
+			addFields(schema, p.Type)
+			continue
+		}
+		field := &Field{
+			Name: p.Name,
+			Type: dataTypeOf(reflect.New(p.Type).Elem()),
+		}
+		schema.Fields = append(schema.Fields, field)
+		schema.FieldNames = append(schema.FieldNames, field.Name)
+		schema.fieldMap[field.Name] = field
+	}
+}
@@ -0,0 +1,52 @@
+package schema
+
+import "testing"
+
+type User struct {
+	Name string
+	Age  int
+}
+
+// Base 模拟一个被其他结构体内嵌的基础字段集合。
+type Base struct {
+	ID int
+}
+
+// Admin 内嵌了 Base，解析时应当把 Base 的导出字段展开到 Admin 自己的列里。
+type Admin struct {
+	Base
+	Name string
+}
+
+func TestParse(t *testing.T) {
+	schema := Parse(&User{})
+	if schema.Name != "User" {
+		t.Fatal("failed to parse table name")
+	}
+	if len(schema.Fields) != 2 || len(schema.FieldNames) != 2 {
+		t.Fatal("failed to parse exported fields")
+	}
+	if schema.GetField("Name").Type != "text" {
+		t.Fatal("failed to parse Name field type")
+	}
+}
+
+// TestParse_Embedded 验证匿名内嵌结构体的导出字段会被展开为当前表的列。
+func TestParse_Embedded(t *testing.T) {
+	schema := Parse(&Admin{})
+	if len(schema.FieldNames) != 2 {
+		t.Fatal("failed to flatten embedded struct fields")
+	}
+	if schema.GetField("ID") == nil {
+		t.Fatal("failed to promote embedded field ID")
+	}
+}
+
+// TestParse_PointerReceiver 验证 Parse 接受的是结构体指针，而不是结构体值本身。
+func TestParse_PointerReceiver(t *testing.T) {
+	u := &User{Name: "Tom", Age: 18}
+	schema := Parse(u)
+	if schema.Model.(*User) != u {
+		t.Fatal("failed to keep the original pointer as Model")
+	}
+}
@@ -0,0 +1,72 @@
+/*
+-- 模块职责简图 --
+
+   [ main.go ]
+       │
+       ▼
+   [ geeorm.Engine ]
+       ├── 初始化数据库连接（driver 决定用哪个 dialect.Dialect）
+       └── 创建 Session
+            │
+            ▼
+   [ session.Session ]
+       ├── Raw(sql, args...)            → 构建原始 SQL（按 dialect 改写占位符）
+       ├── Model(&User{})               → 绑定结构体，按 dialect 做类型映射解析出 Schema
+       ├── CreateTable / DropTable / HasTable
+       ├── Insert(values ...interface{}) → 结构体 -> 行
+       └── Find(&users)                  → 行 -> 结构体切片
+*/
+
+package main
+
+/*
+   这个 main.go 文件被放在 day3-dialect/cmd_test/ 目录，而不是项目顶层，是为了：
+   作为测试/演示用的可执行程序，和框架核心代码隔离开来，保持项目结构清晰、职责分明。
+*/
+
+import (
+	"fmt"            // 用于打印结果到控制台
+	"geeorm"         // 引入我们自己实现的 geeorm 包
+	"geeorm/dialect" // 引入方言注册表，演示 driver 名字如何解析成 dialect.Dialect
+
+	_ "github.com/mattn/go-sqlite3" // 导入 SQLite3 驱动（注册 init()，但不直接引用）
+)
+
+// User 是本次演示用的结构体，Model() 会把它解析成一张同名的表。
+type User struct {
+	Name string
+	Age  int
+}
+
+func main() {
+	// "sqlite3" 这个 driver 名字会在 NewEngine 内部通过 dialect.GetDialect 解析成
+	// 对应的 dialect.Dialect 实现，这里单独取一次来证明注册表确实按 driver 名字工作。
+	dial, ok := dialect.GetDialect("sqlite3")
+	if !ok {
+		panic("dialect sqlite3 not registered")
+	}
+	fmt.Printf("resolved dialect: %T\n", dial)
+
+	// 创建数据库引擎（连接数据库）
+	engine, _ := geeorm.NewEngine("sqlite3", "gee.db")
+	defer engine.Close() // main 函数结束前关闭数据库连接
+
+	s := engine.NewSession().Model(&User{})
+
+	// 保证每次运行都是干净的表
+	_ = s.DropTable()
+	_ = s.CreateTable()
+
+	if !s.HasTable() {
+		panic("CreateTable failed")
+	}
+
+	// 通过反射把结构体指针批量插入
+	count, _ := s.Insert(&User{Name: "Tom", Age: 18}, &User{Name: "Sam", Age: 25})
+	fmt.Printf("Insert success, %d affected\n", count)
+
+	// 查询全部数据，反射写回结构体切片
+	var users []User
+	_ = s.Find(&users)
+	fmt.Printf("Find success, %v\n", users)
+}
@@ -0,0 +1,82 @@
+/*
+-- 模块职责简图 --
+
+   [ main.go ]
+       │
+       ▼
+   [ geeorm.Engine ]
+       ├── 初始化数据库连接
+       ├── Transaction(func(s *session.Session) (interface{}, error))  → 自动提交/回滚
+       └── 创建 Session
+            │
+            ▼
+   [ session.Session ]
+       ├── Raw(sql, args...)            → 构建原始 SQL
+       ├── Model(&User{})               → 绑定结构体，解析出 Schema
+       ├── CreateTable / DropTable / HasTable
+       ├── Insert(values ...interface{}) → 结构体 -> 行
+       ├── Find(&users)                  → 行 -> 结构体切片
+       └── Begin / Commit / Rollback     → 手动控制事务，嵌套调用退化为 SAVEPOINT
+*/
+
+package main
+
+/*
+   这个 main.go 文件被放在 day4-transaction/cmd_test/ 目录，而不是项目顶层，是为了：
+   作为测试/演示用的可执行程序，和框架核心代码隔离开来，保持项目结构清晰、职责分明。
+*/
+
+import (
+	"errors"
+	"fmt"    // 用于打印结果到控制台
+	"geeorm" // 引入我们自己实现的 geeorm 包
+	"geeorm/session"
+
+	_ "github.com/mattn/go-sqlite3" // 导入 SQLite3 驱动（注册 init()，但不直接引用）
+)
+
+// User 是本次演示用的结构体，Model() 会把它解析成一张同名的表。
+type User struct {
+	Name string
+	Age  int
+}
+
+func main() {
+	// 创建数据库引擎（连接数据库）
+	engine, _ := geeorm.NewEngine("sqlite3", "gee.db")
+	defer engine.Close() // main 函数结束前关闭数据库连接
+
+	s := engine.NewSession().Model(&User{})
+
+	// 保证每次运行都是干净的表
+	_ = s.DropTable()
+	_ = s.CreateTable()
+
+	if !s.HasTable() {
+		panic("CreateTable failed")
+	}
+
+	// 用 Engine.Transaction 插入两行：f 正常返回时自动 Commit。
+	_, _ = engine.Transaction(func(s *session.Session) (interface{}, error) {
+		return s.Insert(&User{Name: "Tom", Age: 18}, &User{Name: "Sam", Age: 25})
+	})
+
+	// 故意让第二次事务失败：内层 Begin/Rollback 退化为 SAVEPOINT，
+	// 外层在 f 返回 error 时整体回滚，验证 "Jack" 这一行不会留在表里。
+	_, err := engine.Transaction(func(s *session.Session) (interface{}, error) {
+		if _, err := s.Insert(&User{Name: "Jack", Age: 30}); err != nil {
+			return nil, err
+		}
+		if err := s.Begin(); err != nil { // 嵌套 Begin，发出 SAVEPOINT
+			return nil, err
+		}
+		_ = s.Rollback() // 回滚到 SAVEPOINT，不影响外层事务本身
+		return nil, errors.New("force outer rollback")
+	})
+	fmt.Printf("transaction with forced rollback: %v\n", err)
+
+	// 查询全部数据：应该只有 Tom 和 Sam，Jack 已经被外层回滚。
+	var users []User
+	_ = s.Find(&users)
+	fmt.Printf("Find success, %v\n", users)
+}
@@ -0,0 +1,73 @@
+package dialect
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegisterAndGetDialect(t *testing.T) {
+	if _, ok := GetDialect("sqlite3"); !ok {
+		t.Fatal("sqlite3 dialect should have self-registered via init()")
+	}
+	if _, ok := GetDialect("mysql"); !ok {
+		t.Fatal("mysql dialect should have self-registered via init()")
+	}
+	if _, ok := GetDialect("postgres"); !ok {
+		t.Fatal("postgres dialect should have self-registered via init()")
+	}
+	if _, ok := GetDialect("no-such-dialect"); ok {
+		t.Fatal("GetDialect should report false for an unregistered name")
+	}
+}
+
+func TestMysqlDataTypeOf(t *testing.T) {
+	m, _ := GetDialect("mysql")
+	if got := m.DataTypeOf(reflect.ValueOf("")); got != "varchar(255)" {
+		t.Fatalf("string: got %s, want varchar(255)", got)
+	}
+	if got := m.DataTypeOf(reflect.ValueOf(0)); got != "bigint" {
+		t.Fatalf("int: got %s, want bigint", got)
+	}
+	if got := m.DataTypeOf(reflect.ValueOf(0.0)); got != "double" {
+		t.Fatalf("float64: got %s, want double", got)
+	}
+	if got := m.DataTypeOf(reflect.ValueOf(true)); got != "tinyint(1)" {
+		t.Fatalf("bool: got %s, want tinyint(1)", got)
+	}
+}
+
+func TestPostgresDataTypeOf(t *testing.T) {
+	p, _ := GetDialect("postgres")
+	if got := p.DataTypeOf(reflect.ValueOf("")); got != "text" {
+		t.Fatalf("string: got %s, want text", got)
+	}
+	if got := p.DataTypeOf(reflect.ValueOf(0)); got != "bigint" {
+		t.Fatalf("int: got %s, want bigint", got)
+	}
+	if got := p.DataTypeOf(reflect.ValueOf(true)); got != "boolean" {
+		t.Fatalf("bool: got %s, want boolean", got)
+	}
+}
+
+// TestPostgresRewritePlaceholders 验证 "?" 占位符按出现顺序被改写成 "$1", "$2", ...
+func TestPostgresRewritePlaceholders(t *testing.T) {
+	p, _ := GetDialect("postgres")
+	rewriter, ok := p.(PlaceholderRewriter)
+	if !ok {
+		t.Fatal("postgres dialect should implement PlaceholderRewriter")
+	}
+	got := rewriter.RewritePlaceholders("SELECT * FROM user WHERE name = ? AND age > ?")
+	want := "SELECT * FROM user WHERE name = $1 AND age > $2"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestSqlite3NotAPlaceholderRewriter 验证 sqlite3 没有实现 PlaceholderRewriter，
+// 因为它的占位符风格本来就是 "?"，不需要改写。
+func TestSqlite3NotAPlaceholderRewriter(t *testing.T) {
+	s, _ := GetDialect("sqlite3")
+	if _, ok := s.(PlaceholderRewriter); ok {
+		t.Fatal("sqlite3 should not implement PlaceholderRewriter")
+	}
+}
@@ -0,0 +1,78 @@
+/*
+transaction.go 给 Session 加上事务能力：
+  - Begin/Commit/Rollback 包装 *sql.DB 的 BeginTx/Commit/Rollback；
+  - 如果在一个已经开启的事务里再次 Begin，退化为 SAVEPOINT，支持嵌套事务。
+*/
+package session
+
+import (
+	"context"
+	"fmt"
+	"geeorm/log"
+)
+
+// Begin 开启一个事务。如果 Session 已经处于事务中，则建立一个新的 SAVEPOINT，
+// 这样同一个 Session 上嵌套调用 Begin/Commit/Rollback 也能得到正确的语义。
+func (s *Session) Begin() (err error) {
+	if s.tx != nil {
+		s.txDepth++
+		sp := s.savepointName()
+		log.Info("savepoint", sp)
+		if _, err = s.tx.Exec(fmt.Sprintf("SAVEPOINT %s", sp)); err != nil {
+			log.Error(err)
+		}
+		return
+	}
+
+	log.Info("transaction begin")
+	if s.tx, err = s.db.BeginTx(context.Background(), nil); err != nil {
+		log.Error(err)
+		return
+	}
+	return
+}
+
+// Commit 提交当前事务；如果当前处于嵌套层级，则释放对应的 SAVEPOINT。
+func (s *Session) Commit() (err error) {
+	if s.txDepth > 0 {
+		sp := s.savepointName()
+		s.txDepth--
+		log.Info("release savepoint", sp)
+		if _, err = s.tx.Exec(fmt.Sprintf("RELEASE SAVEPOINT %s", sp)); err != nil {
+			log.Error(err)
+		}
+		return
+	}
+
+	log.Info("transaction commit")
+	if err = s.tx.Commit(); err != nil {
+		log.Error(err)
+	}
+	s.tx = nil
+	return
+}
+
+// Rollback 回滚当前事务；如果当前处于嵌套层级，则只回滚到对应的 SAVEPOINT。
+func (s *Session) Rollback() (err error) {
+	if s.txDepth > 0 {
+		sp := s.savepointName()
+		s.txDepth--
+		log.Info("rollback to savepoint", sp)
+		if _, err = s.tx.Exec(fmt.Sprintf("ROLLBACK TO SAVEPOINT %s", sp)); err != nil {
+			log.Error(err)
+		}
+		return
+	}
+
+	log.Info("transaction rollback")
+	if err = s.tx.Rollback(); err != nil {
+		log.Error(err)
+	}
+	s.tx = nil
+	return
+}
+
+// savepointName 给当前嵌套层级生成一个确定的 SAVEPOINT 名字。
+func (s *Session) savepointName() string {
+	return fmt.Sprintf("sp_%d", s.txDepth)
+}
@@ -0,0 +1,29 @@
+/*
+transaction.go 提供一个帮助函数，把"开启事务 -> 执行 -> 提交/回滚"这套样板代码
+收敛到一处，调用方只需要关心业务逻辑本身。
+*/
+package geeorm
+
+import "geeorm/session"
+
+// Transaction 在一个事务里执行 f，f 返回 nil error 时提交事务，否则回滚；
+// 如果 f 内部发生 panic，同样会先回滚，再把 panic 重新抛出，保证不会泄露未提交的事务。
+func (engine *Engine) Transaction(f func(s *session.Session) (interface{}, error)) (result interface{}, err error) {
+	s := engine.NewSession()
+	if err = s.Begin(); err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = s.Rollback()
+			panic(p) // 重新抛出，调用方应该仍然能观察到 panic
+		} else if err != nil {
+			_ = s.Rollback()
+		} else {
+			err = s.Commit()
+		}
+	}()
+
+	return f(s)
+}
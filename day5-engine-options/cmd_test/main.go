@@ -0,0 +1,88 @@
+/*
+-- 模块职责简图 --
+
+   [ main.go ]
+       │
+       ▼
+   [ geeorm.Engine ]
+       ├── 初始化数据库连接（可用 Option 调优连接池，或用 NewEngineWithDB 复用已有 *sql.DB）
+       ├── Stats() / PingContext(ctx)  → 连接池可观测性
+       └── 创建 Session
+            │
+            ▼
+   [ session.Session ]
+       ├── Raw(sql, args...)            → 构建原始 SQL
+       ├── Model(&User{})               → 绑定结构体，解析出 Schema
+       ├── CreateTable / DropTable / HasTable
+       ├── Insert(values ...interface{}) → 结构体 -> 行
+       └── Find(&users)                  → 行 -> 结构体切片
+*/
+
+package main
+
+/*
+   这个 main.go 文件被放在 day5-engine-options/cmd_test/ 目录，而不是项目顶层，是为了：
+   作为测试/演示用的可执行程序，和框架核心代码隔离开来，保持项目结构清晰、职责分明。
+*/
+
+import (
+	"context"
+	"database/sql"
+	"fmt"    // 用于打印结果到控制台
+	"geeorm" // 引入我们自己实现的 geeorm 包
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // 导入 SQLite3 驱动（注册 init()，但不直接引用）
+)
+
+// User 是本次演示用的结构体，Model() 会把它解析成一张同名的表。
+type User struct {
+	Name string
+	Age  int
+}
+
+func main() {
+	// 用 Option 调优连接池：限制同时打开的连接数、连接最长存活时间。
+	engine, err := geeorm.NewEngine("sqlite3", "gee.db",
+		geeorm.WithMaxOpenConns(5),
+		geeorm.WithMaxIdleConns(2),
+		geeorm.WithConnMaxLifetime(time.Hour),
+	)
+	if err != nil {
+		panic(err)
+	}
+	defer engine.Close() // main 函数结束前关闭数据库连接
+
+	if err := engine.PingContext(context.Background()); err != nil {
+		panic(err)
+	}
+	fmt.Printf("pool stats: %+v\n", engine.Stats())
+
+	s := engine.NewSession().Model(&User{})
+
+	// 保证每次运行都是干净的表
+	_ = s.DropTable()
+	_ = s.CreateTable()
+
+	if !s.HasTable() {
+		panic("CreateTable failed")
+	}
+
+	// 通过反射把结构体指针批量插入
+	count, _ := s.Insert(&User{Name: "Tom", Age: 18}, &User{Name: "Sam", Age: 25})
+	fmt.Printf("Insert success, %d affected\n", count)
+
+	// 查询全部数据，反射写回结构体切片
+	var users []User
+	_ = s.Find(&users)
+	fmt.Printf("Find success, %v\n", users)
+
+	// NewEngineWithDB 演示：复用一个调用方已经准备好的 *sql.DB，跳过 sql.Open。
+	rawDB, _ := sql.Open("sqlite3", "gee.db")
+	engine2, err := geeorm.NewEngineWithDB("sqlite3", rawDB)
+	if err != nil {
+		panic(err)
+	}
+	defer engine2.Close()
+	fmt.Printf("engine2 shares the same *sql.DB: %v\n", engine2.DB() == rawDB)
+}
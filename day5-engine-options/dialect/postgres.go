@@ -0,0 +1,56 @@
+package dialect
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type postgres struct{}
+
+var _ Dialect = (*postgres)(nil)
+
+func init() {
+	RegisterDialect("postgres", &postgres{})
+}
+
+func (p *postgres) DataTypeOf(typ reflect.Value) string {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "bigint"
+	case reflect.Float32, reflect.Float64:
+		return "double precision"
+	case reflect.String:
+		return "text"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Struct:
+		if _, ok := typ.Interface().(time.Time); ok {
+			return "timestamp"
+		}
+	}
+	panic(fmt.Sprintf("invalid postgres type: %s (%s)", typ.Type().Name(), typ.Kind()))
+}
+
+func (p *postgres) TableExistsSQL(tableName string) (string, []interface{}) {
+	args := []interface{}{tableName}
+	return "SELECT 1 FROM information_schema.tables WHERE table_name = $1", args
+}
+
+// RewritePlaceholders 把 sql 中的 "?" 占位符按出现顺序改写成 postgres 需要的 "$1", "$2", ...
+// 这样上层 session.Raw("... ? ? ...") 的写法不需要关心底层到底是哪种数据库。
+func (p *postgres) RewritePlaceholders(sql string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range sql {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
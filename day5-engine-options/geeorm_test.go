@@ -0,0 +1,84 @@
+package geeorm
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+)
+
+// fakeConn/fakeDriver 是一个只满足 database/sql 最低要求的假驱动：Open 永远成功，
+// 不支持真正的查询。这样就能在不引入 cgo sqlite3 依赖的情况下，对连接池选项和
+// NewEngineWithDB 这类不关心 SQL 执行、只关心 *sql.DB 配置的逻辑做单元测试。
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return nil, errors.New("not implemented") }
+func (fakeConn) Close() error                               { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                  { return nil, errors.New("not implemented") }
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+func init() {
+	sql.Register("geeorm-fake", fakeDriver{})
+}
+
+// TestPoolOptionsAppliedBeforePing 验证 WithMaxOpenConns 等选项在 Ping 之前就已经
+// 生效：它们只是调整 *sql.DB 内部的连接池配置，不需要真正查询数据库。Option 本身
+// 就是 func(db *sql.DB)，NewEngine 里的应用方式就是依次调用，这里照搬同一种用法。
+func TestPoolOptionsAppliedBeforePing(t *testing.T) {
+	db, err := sql.Open("geeorm-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, opt := range []Option{
+		WithMaxOpenConns(3),
+		WithMaxIdleConns(1),
+		WithConnMaxLifetime(time.Minute),
+	} {
+		opt(db)
+	}
+
+	engine, err := NewEngineWithDB("sqlite3", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer engine.Close()
+
+	stats := engine.Stats()
+	if stats.MaxOpenConnections != 3 {
+		t.Fatalf("MaxOpenConnections: got %d, want 3", stats.MaxOpenConnections)
+	}
+}
+
+// TestNewEngineWithDBReusesCallerDB 验证 NewEngineWithDB 不会另外打开一个连接，
+// 而是直接复用调用方传进来的 *sql.DB。
+func TestNewEngineWithDBReusesCallerDB(t *testing.T) {
+	db, err := sql.Open("geeorm-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := NewEngineWithDB("sqlite3", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer engine.Close()
+
+	if engine.DB() != db {
+		t.Fatal("NewEngineWithDB should reuse the exact *sql.DB passed in, not open a new one")
+	}
+}
+
+// TestNewEngineWithDBUnknownDialect 验证 driver 名字找不到对应 dialect 时报错，
+// 而不是 panic 或者悄悄地用一个错误的方言继续跑下去。
+func TestNewEngineWithDBUnknownDialect(t *testing.T) {
+	db, err := sql.Open("geeorm-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewEngineWithDB("no-such-dialect", db); err == nil {
+		t.Fatal("expected an error for an unregistered dialect")
+	}
+}
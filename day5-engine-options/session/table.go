@@ -0,0 +1,119 @@
+/*
+table.go 在 raw.go 提供的原始 SQL 能力之上，加了一层基于 reflect 的对象-表映射：
+  - Model 把一个结构体指针与 Session 绑定，并解析出它的 Schema；
+  - CreateTable / DropTable / HasTable 根据 Schema 生成并执行建表相关的 SQL；
+  - Insert / Find 把结构体切片与表的行互相转换。
+*/
+package session
+
+import (
+	"fmt"
+	"geeorm/schema"
+	"reflect"
+	"strings"
+)
+
+// Model 解析 value 对应的表结构，并缓存在 Session 上，后续的
+// CreateTable / Insert / Find 等方法都依赖这个缓存，避免重复反射。
+func (s *Session) Model(value interface{}) *Session {
+	if s.refTable == nil || reflect.TypeOf(value) != reflect.TypeOf(s.refTable.Model) {
+		s.refTable = schema.Parse(value, s.dialect)
+	}
+	return s
+}
+
+// RefTable 返回当前 Session 绑定的 Schema，调用前必须先调用 Model。
+func (s *Session) RefTable() *schema.Schema {
+	if s.refTable == nil {
+		panic("Model is not set, call Session.Model() first")
+	}
+	return s.refTable
+}
+
+// CreateTable 根据 RefTable 生成的列信息创建表。
+func (s *Session) CreateTable() error {
+	table := s.RefTable()
+	var columns []string
+	for _, field := range table.Fields {
+		columns = append(columns, fmt.Sprintf("%s %s", field.Name, field.Type))
+	}
+	desc := strings.Join(columns, ",")
+	_, err := s.Raw(fmt.Sprintf("CREATE TABLE %s (%s);", table.Name, desc)).Exec()
+	return err
+}
+
+// DropTable 删除 RefTable 对应的表，表不存在也不会报错。
+func (s *Session) DropTable() error {
+	_, err := s.Raw(fmt.Sprintf("DROP TABLE IF EXISTS %s", s.RefTable().Name)).Exec()
+	return err
+}
+
+// HasTable 判断 RefTable 对应的表是否已经存在，具体 SQL 由当前方言提供。
+func (s *Session) HasTable() bool {
+	sql, values := s.dialect.TableExistsSQL(s.RefTable().Name)
+	row := s.Raw(sql, values...).QueryRow()
+	var tmp string
+	return row.Scan(&tmp) == nil
+}
+
+// Insert 把一个或多个结构体指针批量写入 RefTable 对应的表。
+// 每个参数都会经过 Model，RefTable 取自最后一个参数的类型（因此调用前不需要先调用 Model）。
+func (s *Session) Insert(values ...interface{}) (int64, error) {
+	recordValues := make([]interface{}, 0)
+	for _, value := range values {
+		table := s.Model(value).RefTable()
+		recordValues = append(recordValues, table.RecordValues(value))
+	}
+
+	table := s.RefTable()
+	sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", table.Name, strings.Join(table.FieldNames, ","))
+	var placeholders []string
+	var vars []interface{}
+	for _, record := range recordValues {
+		row := record.([]interface{})
+		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.TrimSuffix(strings.Repeat("?,", len(row)), ",")))
+		vars = append(vars, row...)
+	}
+	sql += strings.Join(placeholders, ",")
+
+	result, err := s.Raw(sql, vars...).Exec()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Find 查询 RefTable 对应的所有行，并把结果填入 slicePtr 指向的切片。
+// slicePtr 必须是指向 []T 或 []*T 的指针，T 是已经 Model() 过的结构体类型。
+func (s *Session) Find(slicePtr interface{}) error {
+	destSlice := reflect.Indirect(reflect.ValueOf(slicePtr))
+	destType := destSlice.Type().Elem()
+	elemIsPtr := destType.Kind() == reflect.Ptr
+	if elemIsPtr {
+		destType = destType.Elem() // []*T：按 T 解析表结构，追加时再取地址
+	}
+	table := s.Model(reflect.New(destType).Interface()).RefTable()
+
+	sql := fmt.Sprintf("SELECT %s FROM %s", strings.Join(table.FieldNames, ","), table.Name)
+	rows, err := s.Raw(sql).QueryRows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		dest := reflect.New(destType).Elem()
+		var fieldAddrs []interface{}
+		for _, name := range table.FieldNames {
+			fieldAddrs = append(fieldAddrs, dest.FieldByName(name).Addr().Interface())
+		}
+		if err := rows.Scan(fieldAddrs...); err != nil {
+			return err
+		}
+		if elemIsPtr {
+			dest = dest.Addr()
+		}
+		destSlice.Set(reflect.Append(destSlice, dest))
+	}
+	return rows.Close()
+}
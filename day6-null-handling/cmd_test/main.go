@@ -0,0 +1,68 @@
+/*
+-- 模块职责简图 --
+
+   [ main.go ]
+       │
+       ▼
+   [ geeorm.Engine ]
+       ├── 初始化数据库连接
+       └── 创建 Session
+            │
+            ▼
+   [ session.Session ]
+       ├── Raw(sql, args...)            → 构建原始 SQL
+       ├── Model(&Profile{})            → 绑定结构体，sql.NullXxx 字段映射成可为 NULL 的列
+       ├── CreateTable / DropTable / HasTable
+       ├── Insert(values ...interface{}) → 结构体 -> 行
+       └── Find(&profiles)                → 行 -> 结构体切片，NULL 列被安全地置为零值
+*/
+
+package main
+
+/*
+   这个 main.go 文件被放在 day6-null-handling/cmd_test/ 目录，而不是项目顶层，是为了：
+   作为测试/演示用的可执行程序，和框架核心代码隔离开来，保持项目结构清晰、职责分明。
+*/
+
+import (
+	"database/sql"
+	"fmt"    // 用于打印结果到控制台
+	"geeorm" // 引入我们自己实现的 geeorm 包
+
+	_ "github.com/mattn/go-sqlite3" // 导入 SQLite3 驱动（注册 init()，但不直接引用）
+)
+
+// Profile 的 Bio 是 sql.NullString：Model() 会把它解析成一个允许 NULL 的列，
+// Insert 时可以传 sql.NullString{Valid: false}，Find 读回时 NULL 会变成零值。
+type Profile struct {
+	Name string
+	Bio  sql.NullString
+}
+
+func main() {
+	// 创建数据库引擎（连接数据库）
+	engine, _ := geeorm.NewEngine("sqlite3", "gee.db")
+	defer engine.Close() // main 函数结束前关闭数据库连接
+
+	s := engine.NewSession().Model(&Profile{})
+
+	// 保证每次运行都是干净的表
+	_ = s.DropTable()
+	_ = s.CreateTable()
+
+	if !s.HasTable() {
+		panic("CreateTable failed")
+	}
+
+	// Tom 填了 Bio，Sam 没填（对应列是 NULL）。
+	count, _ := s.Insert(
+		&Profile{Name: "Tom", Bio: sql.NullString{String: "loves gee-orm", Valid: true}},
+		&Profile{Name: "Sam", Bio: sql.NullString{}},
+	)
+	fmt.Printf("Insert success, %d affected\n", count)
+
+	// 查询全部数据：Sam 的 Bio 应该被安全地读成零值 sql.NullString{}，而不是报错。
+	var profiles []Profile
+	_ = s.Find(&profiles)
+	fmt.Printf("Find success, %v\n", profiles)
+}
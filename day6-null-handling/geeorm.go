@@ -0,0 +1,148 @@
+/*
+geeorm.go 是整个框架的入口点和核心。它负责数据库连接的建立、测试、管理和关闭，并提供了一个方法来创建用于具体数据库操作的 Session 实例。
+    - 初始化数据库连接（或者接受调用方已经准备好的 *sql.DB）；
+    - 根据 driver 名字找到对应的 SQL 方言；
+    - 封装 *sql.DB 为 Engine，暴露连接池调优和可观测性相关的接口；
+    - 提供创建 Session 的方法；
+    - 管理数据库连接的生命周期（打开、关闭）；
+*/
+
+package geeorm
+
+import (
+	"context"        // PingContext 需要的超时/取消控制
+	"database/sql"   // Go 标准数据库驱动接口
+	"fmt"            // 拼接错误信息
+	"geeorm/dialect" // SQL 方言：类型映射、占位符风格等跟具体数据库相关的差异
+	"geeorm/log"     // 自定义日志模块
+	"geeorm/session" // 会话封装模块
+	"time"           // 连接池调优选项里的时长参数
+)
+
+// Engine 是 ORM 的核心结构体，
+// 主要职责是管理数据库连接（db）、解析出的方言，并创建 Session。
+type Engine struct {
+	db      *sql.DB         // 这个字段持有一个数据库连接池的指针，所有后续的数据库操作都将通过它进行。
+	dialect dialect.Dialect // 根据 driver 名字解析出的 SQL 方言，贯穿到每个 Session
+}
+
+// Option 是 NewEngine 的函数式选项，用于在打开连接之后、Ping 之前调整连接池参数。
+type Option func(db *sql.DB)
+
+// WithMaxOpenConns 对应 sql.DB.SetMaxOpenConns，限制同时打开的连接数上限。
+func WithMaxOpenConns(n int) Option {
+	return func(db *sql.DB) { db.SetMaxOpenConns(n) }
+}
+
+// WithMaxIdleConns 对应 sql.DB.SetMaxIdleConns，限制连接池中保留的空闲连接数。
+func WithMaxIdleConns(n int) Option {
+	return func(db *sql.DB) { db.SetMaxIdleConns(n) }
+}
+
+// WithConnMaxLifetime 对应 sql.DB.SetConnMaxLifetime，限制一个连接的最长存活时间。
+func WithConnMaxLifetime(d time.Duration) Option {
+	return func(db *sql.DB) { db.SetConnMaxLifetime(d) }
+}
+
+// WithConnMaxIdleTime 对应 sql.DB.SetConnMaxIdleTime，限制一个连接最长可以空闲多久。
+func WithConnMaxIdleTime(d time.Duration) Option {
+	return func(db *sql.DB) { db.SetConnMaxIdleTime(d) }
+}
+
+// NewEngine 用于初始化一个 Engine 实例，建立数据库连接。
+//
+// 参数：
+//   - driver: 驱动名称（如 "sqlite3", "mysql", "postgres"），同时也是查找 dialect 的 key
+//   - source: 数据库连接字符串（如 SQLite 文件路径、MySQL DSN）
+//   - opts: 连接池调优选项，在 Ping 之前依次应用到 sql.Open 返回的 *sql.DB 上
+//
+// 返回：
+//   - e: 初始化后的 *Engine 实例
+//   - err: 错误信息，如果连接失败或者 driver 没有对应的 dialect
+func NewEngine(driver, source string, opts ...Option) (e *Engine, err error) {
+	// 第一步：打开数据库连接（不代表立刻建立连接）
+	db, err := sql.Open(driver, source)
+	if err != nil {
+		log.Error(err)
+		return
+	}
+
+	// 第二步：应用连接池选项，必须在 Ping 之前设置好
+	for _, opt := range opts {
+		opt(db)
+	}
+
+	return newEngine(driver, db)
+}
+
+// NewEngineWithDB 接受一个调用方已经准备好的 *sql.DB（比如套了 OpenTelemetry 的驱动，
+// 或者跟其他非 ORM 代码共享同一个连接池），跳过 sql.Open，直接复用它。
+func NewEngineWithDB(driver string, db *sql.DB) (e *Engine, err error) {
+	return newEngine(driver, db)
+}
+
+// newEngine 是 NewEngine 和 NewEngineWithDB 共享的收尾逻辑：Ping 一下确认连通，
+// 再根据 driver 名字解析出 dialect，最后包装成 Engine。
+func newEngine(driver string, db *sql.DB) (e *Engine, err error) {
+	if err = db.PingContext(context.Background()); err != nil {
+		log.Error(err)
+		return
+	}
+
+	dial, ok := dialect.GetDialect(driver)
+	if !ok {
+		err = fmt.Errorf("dialect %s not found", driver)
+		log.Error(err)
+		return
+	}
+
+	e = &Engine{db: db, dialect: dial}
+	log.Info("Connect database success")
+	return
+}
+
+// Close方法 关闭数据库连接，释放资源。
+// 为什么要单独写 Close() 方法？
+//   - 因为我们希望 ORM 有统一的管理接口，而不是直接操作 sql.DB
+func (engine *Engine) Close() {
+	if err := engine.db.Close(); err != nil {
+		log.Error("Failed to close database") // 数据库关闭失败
+	}
+	log.Info("Close database success") // 日志输出：成功关闭
+}
+
+// DB方法 返回底层的 *sql.DB，供需要直接操作连接池的场景使用（比如自定义监控）。
+func (engine *Engine) DB() *sql.DB {
+	return engine.db
+}
+
+// Stats方法 透出 sql.DB 的连接池统计信息，方便接入 Prometheus 等可观测性系统。
+func (engine *Engine) Stats() sql.DBStats {
+	return engine.db.Stats()
+}
+
+// PingContext方法 检查数据库是否连通，调用方可以通过 ctx 控制超时或取消。
+func (engine *Engine) PingContext(ctx context.Context) error {
+	return engine.db.PingContext(ctx)
+}
+
+// NewSession方法 创建一个新的 Session 实例，供 ORM 操作使用。
+// 每次调用都将返回一个全新的 Session 实例，它与 Engine 共享同一个数据库连接池和方言，但拥有独立的 SQL 构建状态
+// Session 内部持有 db，可以构建并执行 SQL 语句。
+func (engine *Engine) NewSession() *session.Session {
+	return session.New(engine.db, engine.dialect)
+}
+
+/* 示例用法：
+   engine, err := geeorm.NewEngine("sqlite3", "gee.db",
+       geeorm.WithMaxOpenConns(10),
+       geeorm.WithConnMaxLifetime(time.Hour),
+   )
+   if err != nil {
+       panic("数据库连接失败")
+   }
+   defer engine.Close()
+
+   session := engine.NewSession()
+   session.Raw("CREATE TABLE User(Name text, Age integer)").Exec()
+*/
@@ -0,0 +1,24 @@
+/*
+convert.go 让用户可以为任意 Go 类型注册一个转换函数，在 session.Find 扫描行数据
+时兜底使用：数据库驱动给出的原始值（int64/float64/[]byte/string/time.Time/nil…）
+不一定能直接赋值给目标字段，比如自定义的 uuid.UUID 或者 JSON 序列化的列。
+*/
+package schema
+
+import "reflect"
+
+// Converter 把数据库驱动返回的原始值 src 转换成可以赋给目标字段的值。
+type Converter func(src interface{}) (interface{}, error)
+
+var converters = map[reflect.Type]Converter{}
+
+// RegisterConverter 为类型 t 注册一个转换函数。
+func RegisterConverter(t reflect.Type, fn Converter) {
+	converters[t] = fn
+}
+
+// LookupConverter 按类型查找已注册的转换函数，不存在时 ok 为 false。
+func LookupConverter(t reflect.Type) (fn Converter, ok bool) {
+	fn, ok = converters[t]
+	return
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type convertTestTag struct {
+	Value string
+}
+
+// TestRegisterAndLookupConverter 验证注册的 Converter 能按类型精确查到，
+// 没注册过的类型 ok 为 false，而不是返回一个零值的 Converter 误导调用方。
+func TestRegisterAndLookupConverter(t *testing.T) {
+	typ := reflect.TypeOf(convertTestTag{})
+	RegisterConverter(typ, func(src interface{}) (interface{}, error) {
+		return convertTestTag{Value: src.(string)}, nil
+	})
+
+	fn, ok := LookupConverter(typ)
+	if !ok {
+		t.Fatal("expected a converter to be found after RegisterConverter")
+	}
+	got, err := fn("hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.(convertTestTag).Value != "hello" {
+		t.Fatalf("got %+v, want Value=hello", got)
+	}
+
+	if _, ok := LookupConverter(reflect.TypeOf(0)); ok {
+		t.Fatal("LookupConverter should report false for a type that was never registered")
+	}
+}
+
+// TestConverterErrorPropagates 验证 Converter 返回的错误会原样透传，
+// 不会被 LookupConverter/RegisterConverter 吞掉。
+func TestConverterErrorPropagates(t *testing.T) {
+	typ := reflect.TypeOf(0.0)
+	wantErr := errors.New("bad value")
+	RegisterConverter(typ, func(src interface{}) (interface{}, error) {
+		return nil, wantErr
+	})
+
+	fn, ok := LookupConverter(typ)
+	if !ok {
+		t.Fatal("expected a converter to be found after RegisterConverter")
+	}
+	if _, err := fn("not a float"); err != wantErr {
+		t.Fatalf("got err %v, want %v", err, wantErr)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+schema.go 负责把 Go 结构体翻译成数据库可以理解的表结构。
+  - 通过 reflect 遍历结构体的导出字段；
+  - 为每个字段推导出对应的 SQL 列名与 SQL 类型（委托给 dialect.Dialect，不同数据库类型名不一样）；
+  - 产出一个 Schema，供 session 构建 CREATE TABLE / INSERT / SELECT 等语句时使用。
+*/
+package schema
+
+import (
+	"database/sql"
+	"geeorm/dialect"
+	"reflect"
+)
+
+// Field 表示数据库表的一列，对应结构体的一个导出字段。
+type Field struct {
+	Name     string // 列名，默认等于字段名
+	Type     string // 列的 SQL 类型，如 "text"、"integer"，由 dialect 决定
+	Nullable bool   // 该列是否允许 NULL；sql.NullXxx 字段允许，其他字段默认 NOT NULL
+}
+
+// nullableTypes 列出 database/sql 提供的、用来表示"可能是 NULL"的包装类型。
+// 用这些类型声明字段，CreateTable 就不会给对应的列加 NOT NULL 约束。
+var nullableTypes = map[reflect.Type]bool{
+	reflect.TypeOf(sql.NullString{}):  true,
+	reflect.TypeOf(sql.NullInt64{}):   true,
+	reflect.TypeOf(sql.NullFloat64{}): true,
+	reflect.TypeOf(sql.NullBool{}):    true,
+	reflect.TypeOf(sql.NullTime{}):    true,
+}
+
+// Schema 是某个结构体对应的表结构。
+type Schema struct {
+	Model      interface{}       // 被解析的结构体实例（指针），供后续 reflect 取值使用
+	Name       string            // 表名，默认等于结构体名
+	Fields     []*Field          // 所有列
+	FieldNames []string          // 所有列名，顺序与 Fields 一致
+	fieldMap   map[string]*Field // 列名 -> Field，便于按名查找
+}
+
+// GetField 根据列名查找对应的 Field，不存在时返回 nil。
+func (schema *Schema) GetField(name string) *Field {
+	return schema.fieldMap[name]
+}
+
+// RecordValues 按 FieldNames 的顺序，取出 dest 对应字段的值，供 INSERT 语句使用。
+func (schema *Schema) RecordValues(dest interface{}) []interface{} {
+	destValue := reflect.Indirect(reflect.ValueOf(dest))
+	var fieldValues []interface{}
+	for _, name := range schema.FieldNames {
+		fieldValues = append(fieldValues, destValue.FieldByName(name).Interface())
+	}
+	return fieldValues
+}
+
+// Parse 接受一个结构体指针 dest，按照 d 这种数据库方言解析出它对应的 Schema。
+// 只处理导出字段（首字母大写）；匿名的结构体字段会被展开，它的导出字段视作当前表的列。
+func Parse(dest interface{}, d dialect.Dialect) *Schema {
+	modelType := reflect.TypeOf(dest).Elem()
+
+	schema := &Schema{
+		Model:    dest,
+		Name:     modelType.Name(),
+		fieldMap: make(map[string]*Field),
+	}
+
+	addFields(schema, modelType, d)
+
+	return schema
+}
+
+// addFields 把 typ 的导出字段追加到 schema 中；遇到匿名结构体字段会递归展开。
+func addFields(schema *Schema, typ reflect.Type, d dialect.Dialect) {
+	for i := 0; i < typ.NumField(); i++ {
+		p := typ.Field(i)
+		if !p.IsExported() {
+			continue
+		}
+		if p.Anonymous && p.Type.Kind() == reflect.Struct {
+			addFields(schema, p.Type, d)
+			continue
+		}
+		field := &Field{
+			Name:     p.Name,
+			Type:     d.DataTypeOf(reflect.New(p.Type).Elem()),
+			Nullable: nullableTypes[p.Type],
+		}
+		schema.Fields = append(schema.Fields, field)
+		schema.FieldNames = append(schema.FieldNames, field.Name)
+		schema.fieldMap[field.Name] = field
+	}
+}
@@ -0,0 +1,168 @@
+/*
+table.go 在 raw.go 提供的原始 SQL 能力之上，加了一层基于 reflect 的对象-表映射：
+  - Model 把一个结构体指针与 Session 绑定，并解析出它的 Schema；
+  - CreateTable / DropTable / HasTable 根据 Schema 生成并执行建表相关的 SQL；
+  - Insert / Find 把结构体切片与表的行互相转换。
+*/
+package session
+
+import (
+	"database/sql"
+	"fmt"
+	"geeorm/schema"
+	"reflect"
+	"strings"
+)
+
+// Model 解析 value 对应的表结构，并缓存在 Session 上，后续的
+// CreateTable / Insert / Find 等方法都依赖这个缓存，避免重复反射。
+func (s *Session) Model(value interface{}) *Session {
+	if s.refTable == nil || reflect.TypeOf(value) != reflect.TypeOf(s.refTable.Model) {
+		s.refTable = schema.Parse(value, s.dialect)
+	}
+	return s
+}
+
+// RefTable 返回当前 Session 绑定的 Schema，调用前必须先调用 Model。
+func (s *Session) RefTable() *schema.Schema {
+	if s.refTable == nil {
+		panic("Model is not set, call Session.Model() first")
+	}
+	return s.refTable
+}
+
+// CreateTable 根据 RefTable 生成的列信息创建表。
+// 默认给每一列加上 NOT NULL，除非它的 Go 类型是 sql.NullXxx（见 Field.Nullable）。
+func (s *Session) CreateTable() error {
+	table := s.RefTable()
+	var columns []string
+	for _, field := range table.Fields {
+		col := fmt.Sprintf("%s %s", field.Name, field.Type)
+		if !field.Nullable {
+			col += " NOT NULL"
+		}
+		columns = append(columns, col)
+	}
+	desc := strings.Join(columns, ",")
+	_, err := s.Raw(fmt.Sprintf("CREATE TABLE %s (%s);", table.Name, desc)).Exec()
+	return err
+}
+
+// DropTable 删除 RefTable 对应的表，表不存在也不会报错。
+func (s *Session) DropTable() error {
+	_, err := s.Raw(fmt.Sprintf("DROP TABLE IF EXISTS %s", s.RefTable().Name)).Exec()
+	return err
+}
+
+// HasTable 判断 RefTable 对应的表是否已经存在，具体 SQL 由当前方言提供。
+func (s *Session) HasTable() bool {
+	sql, values := s.dialect.TableExistsSQL(s.RefTable().Name)
+	row := s.Raw(sql, values...).QueryRow()
+	var tmp string
+	return row.Scan(&tmp) == nil
+}
+
+// Insert 把一个或多个结构体指针批量写入 RefTable 对应的表。
+// 每个参数都会经过 Model，RefTable 取自最后一个参数的类型（因此调用前不需要先调用 Model）。
+func (s *Session) Insert(values ...interface{}) (int64, error) {
+	recordValues := make([]interface{}, 0)
+	for _, value := range values {
+		table := s.Model(value).RefTable()
+		recordValues = append(recordValues, table.RecordValues(value))
+	}
+
+	table := s.RefTable()
+	sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", table.Name, strings.Join(table.FieldNames, ","))
+	var placeholders []string
+	var vars []interface{}
+	for _, record := range recordValues {
+		row := record.([]interface{})
+		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.TrimSuffix(strings.Repeat("?,", len(row)), ",")))
+		vars = append(vars, row...)
+	}
+	sql += strings.Join(placeholders, ",")
+
+	result, err := s.Raw(sql, vars...).Exec()
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Find 查询 RefTable 对应的所有行，并把结果填入 slicePtr 指向的切片。
+// slicePtr 必须是指向 []T 或 []*T 的指针，T 是已经 Model() 过的结构体类型。
+func (s *Session) Find(slicePtr interface{}) error {
+	destSlice := reflect.Indirect(reflect.ValueOf(slicePtr))
+	destType := destSlice.Type().Elem()
+	elemIsPtr := destType.Kind() == reflect.Ptr
+	if elemIsPtr {
+		destType = destType.Elem() // []*T：按 T 解析表结构，追加时再取地址
+	}
+	table := s.Model(reflect.New(destType).Interface()).RefTable()
+
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(table.FieldNames, ","), table.Name)
+	rows, err := s.Raw(query).QueryRows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		dest := reflect.New(destType).Elem()
+		var fieldAddrs []interface{}
+		for _, name := range table.FieldNames {
+			fv := dest.FieldByName(name)
+			if _, ok := fv.Addr().Interface().(sql.Scanner); ok {
+				// 字段自己知道怎么处理 NULL（sql.NullXxx、自定义 Scanner…），直接交给它。
+				fieldAddrs = append(fieldAddrs, fv.Addr().Interface())
+			} else {
+				// 普通字段遇到 NULL 列会让 rows.Scan 报错，用 fieldScanner 兜底。
+				fieldAddrs = append(fieldAddrs, fieldScanner{field: fv})
+			}
+		}
+		if err := rows.Scan(fieldAddrs...); err != nil {
+			return err
+		}
+		if elemIsPtr {
+			dest = dest.Addr()
+		}
+		destSlice.Set(reflect.Append(destSlice, dest))
+	}
+	return rows.Close()
+}
+
+// fieldScanner 把一个普通的结构体字段（string、int、float64……而非 sql.Scanner 实现）
+// 适配成 sql.Scanner，这样 Find 可以统一把所有列地址交给 rows.Scan：
+//   - 列值为 NULL 时，字段被置为对应类型的零值，而不是让 Scan 报错；
+//   - 列值非 NULL 时，字段类型注册过 schema.RegisterConverter 转换函数就优先用它，
+//     否则直接赋值或做类型转换，两者都不行时返回错误。
+type fieldScanner struct {
+	field reflect.Value
+}
+
+func (f fieldScanner) Scan(src interface{}) error {
+	if src == nil {
+		f.field.Set(reflect.Zero(f.field.Type()))
+		return nil
+	}
+
+	if conv, ok := schema.LookupConverter(f.field.Type()); ok {
+		v, err := conv(src)
+		if err != nil {
+			return err
+		}
+		f.field.Set(reflect.ValueOf(v))
+		return nil
+	}
+
+	rv := reflect.ValueOf(src)
+	switch {
+	case rv.Type().AssignableTo(f.field.Type()):
+		f.field.Set(rv)
+	case rv.Type().ConvertibleTo(f.field.Type()):
+		f.field.Set(rv.Convert(f.field.Type()))
+	default:
+		return fmt.Errorf("cannot scan %T into field of type %s", src, f.field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+-- 模块职责简图 --
+
+   [ main.go ]
+       │
+       ▼
+   [ geeorm.Engine ]
+       ├── 初始化数据库连接（WithLogger 换掉默认 Logger，WithSlowQueryThreshold 打开慢查询告警）
+       └── 创建 Session
+            │
+            ▼
+   [ session.Session ]
+       ├── Raw(sql, args...)            → 构建原始 SQL，Exec/QueryRow/QueryRows 按耗时打日志
+       ├── Model(&User{})               → 绑定结构体，解析出 Schema
+       ├── CreateTable / DropTable / HasTable
+       ├── Insert(values ...interface{}) → 结构体 -> 行
+       └── Find(&users)                  → 行 -> 结构体切片
+*/
+
+package main
+
+/*
+   这个 main.go 文件被放在 day7-logger/cmd_test/ 目录，而不是项目顶层，是为了：
+   作为测试/演示用的可执行程序，和框架核心代码隔离开来，保持项目结构清晰、职责分明。
+*/
+
+import (
+	"fmt"        // 用于打印结果到控制台
+	"geeorm"     // 引入我们自己实现的 geeorm 包
+	"geeorm/log" // 引入日志模块，演示 WithLogger 换成 slog 实现
+	"log/slog"
+	"os"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // 导入 SQLite3 驱动（注册 init()，但不直接引用）
+)
+
+// User 是本次演示用的结构体，Model() 会把它解析成一张同名的表。
+type User struct {
+	Name string
+	Age  int
+}
+
+func main() {
+	// 用 WithLogger 换成 slog 适配器，把 SQL 日志改成结构化 JSON 输出；
+	// WithSlowQueryThreshold 打开慢查询告警，这里故意设得很低，方便观察 Warn 输出。
+	slogLogger := log.NewSlogLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	engine, _ := geeorm.NewEngine("sqlite3", "gee.db",
+		geeorm.WithLogger(slogLogger),
+		geeorm.WithSlowQueryThreshold(time.Microsecond),
+	)
+	defer engine.Close() // main 函数结束前关闭数据库连接
+
+	s := engine.NewSession().Model(&User{})
+
+	// 保证每次运行都是干净的表
+	_ = s.DropTable()
+	_ = s.CreateTable()
+
+	if !s.HasTable() {
+		panic("CreateTable failed")
+	}
+
+	// 通过反射把结构体指针批量插入，Exec 会按耗时打一条 Info，并因为阈值极低而触发 Warn。
+	count, _ := s.Insert(&User{Name: "Tom", Age: 18}, &User{Name: "Sam", Age: 25})
+	fmt.Printf("Insert success, %d affected\n", count)
+
+	// 查询全部数据，反射写回结构体切片
+	var users []User
+	_ = s.Find(&users)
+	fmt.Printf("Find success, %v\n", users)
+}
@@ -0,0 +1,61 @@
+/*
+dialect.go 把"跟具体数据库打交道"的差异收敛到一个接口背后：
+  - 不同数据库的列类型名不一样（比如 bool 在 sqlite3 叫 bool，在 mysql 叫 tinyint(1)）；
+  - 判断表是否存在的 SQL 语法也不一样；
+  - 占位符风格也不一样（sqlite3/mysql 用 ?，postgres 用 $1、$2…）。
+schema 和 session 只依赖 Dialect 接口，具体实现通过 RegisterDialect 注册到全局表里。
+*/
+package dialect
+
+import (
+	"database/sql"
+	"reflect"
+	"time"
+)
+
+// Dialect 是某一种数据库方言需要实现的能力。
+type Dialect interface {
+	// DataTypeOf 把一个 Go 值映射成该数据库下的 SQL 类型。
+	DataTypeOf(typ reflect.Value) string
+	// TableExistsSQL 返回检测 tableName 是否存在的 SQL 及其参数。
+	TableExistsSQL(tableName string) (string, []interface{})
+}
+
+// UnwrapNullable 如果 typ 是 database/sql 提供的 NullXxx 包装类型之一，返回它
+// 底层真实类型的零值，这样 DataTypeOf 的实现可以复用已有的类型映射逻辑，不用
+// 对每个 NullXxx 类型单独写一次 switch 分支。不是 NullXxx 类型时原样返回 typ。
+func UnwrapNullable(typ reflect.Value) reflect.Value {
+	switch typ.Interface().(type) {
+	case sql.NullString:
+		return reflect.ValueOf("")
+	case sql.NullInt64:
+		return reflect.ValueOf(int64(0))
+	case sql.NullFloat64:
+		return reflect.ValueOf(float64(0))
+	case sql.NullBool:
+		return reflect.ValueOf(false)
+	case sql.NullTime:
+		return reflect.ValueOf(time.Time{})
+	}
+	return typ
+}
+
+// PlaceholderRewriter 是一个可选的能力：某些数据库（如 postgres）不使用 "?"
+// 作为占位符，需要把它改写成自己的风格（"$1"、"$2"…）。Dialect 实现可以选择
+// 实现它；不实现的话，session 就原样使用用户传入的 SQL。
+type PlaceholderRewriter interface {
+	RewritePlaceholders(sql string) string
+}
+
+var dialectsMap = map[string]Dialect{}
+
+// RegisterDialect 把一个 Dialect 实现注册到全局表里，name 通常是 database/sql 的驱动名。
+func RegisterDialect(name string, dialect Dialect) {
+	dialectsMap[name] = dialect
+}
+
+// GetDialect 按驱动名查找已注册的 Dialect。
+func GetDialect(name string) (dialect Dialect, ok bool) {
+	dialect, ok = dialectsMap[name]
+	return
+}
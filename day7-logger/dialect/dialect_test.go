@@ -0,0 +1,104 @@
+package dialect
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+)
+
+func TestRegisterAndGetDialect(t *testing.T) {
+	if _, ok := GetDialect("sqlite3"); !ok {
+		t.Fatal("sqlite3 dialect should have self-registered via init()")
+	}
+	if _, ok := GetDialect("mysql"); !ok {
+		t.Fatal("mysql dialect should have self-registered via init()")
+	}
+	if _, ok := GetDialect("postgres"); !ok {
+		t.Fatal("postgres dialect should have self-registered via init()")
+	}
+	if _, ok := GetDialect("no-such-dialect"); ok {
+		t.Fatal("GetDialect should report false for an unregistered name")
+	}
+}
+
+func TestMysqlDataTypeOf(t *testing.T) {
+	m, _ := GetDialect("mysql")
+	if got := m.DataTypeOf(reflect.ValueOf("")); got != "varchar(255)" {
+		t.Fatalf("string: got %s, want varchar(255)", got)
+	}
+	if got := m.DataTypeOf(reflect.ValueOf(0)); got != "bigint" {
+		t.Fatalf("int: got %s, want bigint", got)
+	}
+	if got := m.DataTypeOf(reflect.ValueOf(0.0)); got != "double" {
+		t.Fatalf("float64: got %s, want double", got)
+	}
+	if got := m.DataTypeOf(reflect.ValueOf(true)); got != "tinyint(1)" {
+		t.Fatalf("bool: got %s, want tinyint(1)", got)
+	}
+}
+
+func TestPostgresDataTypeOf(t *testing.T) {
+	p, _ := GetDialect("postgres")
+	if got := p.DataTypeOf(reflect.ValueOf("")); got != "text" {
+		t.Fatalf("string: got %s, want text", got)
+	}
+	if got := p.DataTypeOf(reflect.ValueOf(0)); got != "bigint" {
+		t.Fatalf("int: got %s, want bigint", got)
+	}
+	if got := p.DataTypeOf(reflect.ValueOf(true)); got != "boolean" {
+		t.Fatalf("bool: got %s, want boolean", got)
+	}
+}
+
+// TestPostgresRewritePlaceholders 验证 "?" 占位符按出现顺序被改写成 "$1", "$2", ...
+func TestPostgresRewritePlaceholders(t *testing.T) {
+	p, _ := GetDialect("postgres")
+	rewriter, ok := p.(PlaceholderRewriter)
+	if !ok {
+		t.Fatal("postgres dialect should implement PlaceholderRewriter")
+	}
+	got := rewriter.RewritePlaceholders("SELECT * FROM user WHERE name = ? AND age > ?")
+	want := "SELECT * FROM user WHERE name = $1 AND age > $2"
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+// TestSqlite3NotAPlaceholderRewriter 验证 sqlite3 没有实现 PlaceholderRewriter，
+// 因为它的占位符风格本来就是 "?"，不需要改写。
+func TestSqlite3NotAPlaceholderRewriter(t *testing.T) {
+	s, _ := GetDialect("sqlite3")
+	if _, ok := s.(PlaceholderRewriter); ok {
+		t.Fatal("sqlite3 should not implement PlaceholderRewriter")
+	}
+}
+
+// TestUnwrapNullable 验证 sql.NullXxx 被映射到它底层真实类型的零值，其他类型原样返回。
+func TestUnwrapNullable(t *testing.T) {
+	cases := []struct {
+		name string
+		in   interface{}
+		want reflect.Kind
+	}{
+		{"NullString", sql.NullString{}, reflect.String},
+		{"NullInt64", sql.NullInt64{}, reflect.Int64},
+		{"NullFloat64", sql.NullFloat64{}, reflect.Float64},
+		{"NullBool", sql.NullBool{}, reflect.Bool},
+		{"plain string unaffected", "hi", reflect.String},
+	}
+	for _, c := range cases {
+		got := UnwrapNullable(reflect.ValueOf(c.in))
+		if got.Kind() != c.want {
+			t.Errorf("%s: got kind %s, want %s", c.name, got.Kind(), c.want)
+		}
+	}
+}
+
+// TestDataTypeOfNullable 验证 DataTypeOf 对 sql.NullXxx 字段映射到和其底层类型
+// 相同的 SQL 类型，这样 schema.addFields 不需要为 Nullable 字段单独维护一套类型表。
+func TestDataTypeOfNullable(t *testing.T) {
+	s, _ := GetDialect("sqlite3")
+	if got := s.DataTypeOf(reflect.ValueOf(sql.NullString{})); got != "text" {
+		t.Fatalf("sql.NullString: got %s, want text", got)
+	}
+}
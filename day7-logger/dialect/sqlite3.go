@@ -0,0 +1,40 @@
+package dialect
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type sqlite3 struct{}
+
+var _ Dialect = (*sqlite3)(nil)
+
+func init() {
+	RegisterDialect("sqlite3", &sqlite3{})
+}
+
+func (s *sqlite3) DataTypeOf(typ reflect.Value) string {
+	typ = UnwrapNullable(typ)
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "real"
+	case reflect.String:
+		return "text"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Struct:
+		if _, ok := typ.Interface().(time.Time); ok {
+			return "datetime"
+		}
+	}
+	panic(fmt.Sprintf("invalid sqlite3 type: %s (%s)", typ.Type().Name(), typ.Kind()))
+}
+
+func (s *sqlite3) TableExistsSQL(tableName string) (string, []interface{}) {
+	args := []interface{}{tableName}
+	return "SELECT name FROM sqlite_master WHERE type='table' and name = ?", args
+}
@@ -0,0 +1,118 @@
+/*
+log.go 把 geeorm 对日志的需求收敛成一个小接口 Logger：
+  - 默认实现还是原来那套彩色、打印调用位置的 std log 封装；
+  - 也提供一个适配 log/slog 的实现，方便接入 JSON 日志或现有的结构化日志体系；
+  - Session 通过 Logger 接口打日志，不再直接依赖这个包的全局函数，
+    这样 WithLogger 选项才能真正替换掉每条 SQL 的输出方式。
+*/
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	stdlog "log"
+	"os"
+	"sync"
+)
+
+// Logger 是 geeorm 对日志系统的抽象。调用方可以实现它接入 zap、zerolog 等，
+// 也可以直接用 NewSlogLogger 包一层 *slog.Logger。
+type Logger interface {
+	Info(args ...interface{})
+	Infof(format string, args ...interface{})
+	Warn(args ...interface{})
+	Warnf(format string, args ...interface{})
+	Error(args ...interface{})
+	Errorf(format string, args ...interface{})
+	// WithContext 返回一个绑定了 ctx 的 Logger，方便实现把 trace id 等信息带进每条日志。
+	WithContext(ctx context.Context) Logger
+}
+
+// 默认实现基于三个 *log.Logger（最初的 error、info，加上新增的 warn）：用颜色区分级别，级别可以通过 SetLevel 统一关闭。
+var (
+	errorLog = stdlog.New(os.Stdout, "\033[31m[error]\033[0m ", stdlog.LstdFlags|stdlog.Lshortfile)
+	warnLog  = stdlog.New(os.Stdout, "\033[33m[warn ]\033[0m ", stdlog.LstdFlags|stdlog.Lshortfile)
+	infoLog  = stdlog.New(os.Stdout, "\033[34m[info ]\033[0m ", stdlog.LstdFlags|stdlog.Lshortfile)
+	loggers  = []*stdlog.Logger{errorLog, warnLog, infoLog} // 用于统一管理所有日志记录器
+	mu       sync.Mutex                                     // 用于线程安全地修改日志输出级别
+)
+
+// 保留包级别的便捷函数，供 Engine/Session 真正建立起来之前的早期错误使用
+// （比如 sql.Open 失败、Ping 失败），这时候还没有机会读到用户传进来的 WithLogger。
+var (
+	Error  = errorLog.Println
+	Errorf = errorLog.Printf
+	Warn   = warnLog.Println
+	Warnf  = warnLog.Printf
+	Info   = infoLog.Println
+	Infof  = infoLog.Printf
+)
+
+// 定义日志级别常量，使用 iota 自动递增
+const (
+	InfoLevel  = iota // 0，表示显示 info、warn 和 error 日志
+	ErrorLevel        // 1，只显示 error 日志
+	Disabled          // 2，禁用所有日志输出
+)
+
+// SetLevel 设置全局日志等级，控制日志的输出行为
+func SetLevel(level int) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, logger := range loggers {
+		logger.SetOutput(os.Stdout)
+	}
+
+	if ErrorLevel < level {
+		errorLog.SetOutput(io.Discard)
+	}
+	if InfoLevel < level {
+		warnLog.SetOutput(io.Discard)
+		infoLog.SetOutput(io.Discard)
+	}
+}
+
+// defaultLogger 是 Logger 接口的默认实现，直接复用上面那三个彩色 *log.Logger。
+// calldepth 决定 Lshortfile 往上找几层调用栈 —— 标准库 Print 系列用 2，报告调用
+// Print 的那一行；这里多加了 1，报告的是调用 Session 方法（Exec、Begin 等直接调用
+// s.logger.* 的方法）的那一行，而不是 session 包内部的行。
+type defaultLogger struct {
+	calldepth int
+}
+
+// NewDefaultLogger 返回彩色、带调用位置的默认 Logger 实现，NewEngine 在没有
+// 通过 WithLogger 指定其他实现时就使用它。
+func NewDefaultLogger() Logger {
+	return &defaultLogger{calldepth: 3}
+}
+
+func (l *defaultLogger) Info(args ...interface{}) {
+	_ = infoLog.Output(l.calldepth, fmt.Sprintln(args...))
+}
+
+func (l *defaultLogger) Infof(format string, args ...interface{}) {
+	_ = infoLog.Output(l.calldepth, fmt.Sprintf(format, args...))
+}
+
+func (l *defaultLogger) Warn(args ...interface{}) {
+	_ = warnLog.Output(l.calldepth, fmt.Sprintln(args...))
+}
+
+func (l *defaultLogger) Warnf(format string, args ...interface{}) {
+	_ = warnLog.Output(l.calldepth, fmt.Sprintf(format, args...))
+}
+
+func (l *defaultLogger) Error(args ...interface{}) {
+	_ = errorLog.Output(l.calldepth, fmt.Sprintln(args...))
+}
+
+func (l *defaultLogger) Errorf(format string, args ...interface{}) {
+	_ = errorLog.Output(l.calldepth, fmt.Sprintf(format, args...))
+}
+
+// WithContext 默认实现不需要从 ctx 里取任何东西，原样返回自己即可。
+func (l *defaultLogger) WithContext(_ context.Context) Logger {
+	return l
+}
@@ -0,0 +1,39 @@
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// reportsAt calls logger.Info from inside this helper, mirroring how
+// Session.Begin/Commit/Rollback and Session.Exec/QueryRow/QueryRows call
+// s.logger.* directly (no extra forwarding function in between).
+func reportsAt(logger Logger) {
+	logger.Info("hello")
+}
+
+// TestDefaultLoggerReportsCallerLine 验证 defaultLogger 的 calldepth 设置正确：
+// Lshortfile 应该报告调用 reportsAt（代表 Begin/Commit/Rollback、Exec/QueryRow/
+// QueryRows 这类直接调用 s.logger.* 的方法）的那一行，而不是 reportsAt 内部、
+// 也不是 log.go 自己的某一行。chunk0-6 review 指出过一次 calldepth 选错会让
+// Exec 这类调用在日志里显示成 session 包自己在打日志，这里把它钉死成一个测试。
+func TestDefaultLoggerReportsCallerLine(t *testing.T) {
+	var buf bytes.Buffer
+	infoLog.SetOutput(&buf)
+	defer infoLog.SetOutput(os.Stdout)
+
+	logger := NewDefaultLogger()
+	_, _, callLine, _ := runtime.Caller(0)
+	reportsAt(logger) // 这一行的行号就是下面断言要匹配的 callLine+1
+	wantLine := callLine + 1
+
+	want := fmt.Sprintf("log_test.go:%d:", wantLine)
+	got := buf.String()
+	if !strings.Contains(got, want) {
+		t.Fatalf("expected log output to contain %q (caller of reportsAt), got %q", want, got)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+slog.go 提供一个把 *slog.Logger 适配成 Logger 接口的薄封装，这样已经在用
+log/slog（或者通过它接入 zap/zerolog 的项目）可以直接复用现有的日志管道，
+不需要维护两套日志配置。
+*/
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// slogLogger 把 Logger 接口的调用翻译成 slog.Logger 的对应方法。
+type slogLogger struct {
+	l   *slog.Logger
+	ctx context.Context
+}
+
+// NewSlogLogger 用一个 *slog.Logger 构造一个 Logger。
+func NewSlogLogger(l *slog.Logger) Logger {
+	return &slogLogger{l: l, ctx: context.Background()}
+}
+
+func (s *slogLogger) Info(args ...interface{}) {
+	s.l.InfoContext(s.ctx, fmt.Sprintln(args...))
+}
+
+func (s *slogLogger) Infof(format string, args ...interface{}) {
+	s.l.InfoContext(s.ctx, fmt.Sprintf(format, args...))
+}
+
+func (s *slogLogger) Warn(args ...interface{}) {
+	s.l.WarnContext(s.ctx, fmt.Sprintln(args...))
+}
+
+func (s *slogLogger) Warnf(format string, args ...interface{}) {
+	s.l.WarnContext(s.ctx, fmt.Sprintf(format, args...))
+}
+
+func (s *slogLogger) Error(args ...interface{}) {
+	s.l.ErrorContext(s.ctx, fmt.Sprintln(args...))
+}
+
+func (s *slogLogger) Errorf(format string, args ...interface{}) {
+	s.l.ErrorContext(s.ctx, fmt.Sprintf(format, args...))
+}
+
+// WithContext 返回一个绑定了 ctx 的 Logger；之后每条日志都通过 slog 的 *Context 方法
+// 把这个 ctx 交给 Handler，Handler 可以从中取出 trace id 等属性。
+func (s *slogLogger) WithContext(ctx context.Context) Logger {
+	return &slogLogger{l: s.l, ctx: ctx}
+}
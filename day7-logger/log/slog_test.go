@@ -0,0 +1,93 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func newSlogTestLogger(buf *bytes.Buffer) Logger {
+	return NewSlogLogger(slog.New(slog.NewJSONHandler(buf, nil)))
+}
+
+// decodeLastLine 解析 buf 中最后一条 JSON 日志记录，方便按字段断言 level/msg。
+func decodeLastLine(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	t.Helper()
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &record); err != nil {
+		t.Fatalf("failed to decode log line %q: %v", lines[len(lines)-1], err)
+	}
+	return record
+}
+
+// TestSlogLoggerLevelsAndMessage 验证 Info/Warn/Error 及其 f 变体都落到了
+// 对应的 slog 级别上，消息内容也如期拼接/格式化。
+func TestSlogLoggerLevelsAndMessage(t *testing.T) {
+	cases := []struct {
+		name      string
+		call      func(l Logger)
+		wantLevel string
+		wantMsg   string
+	}{
+		{"Info", func(l Logger) { l.Info("hello", "world") }, "INFO", "hello world\n"},
+		{"Infof", func(l Logger) { l.Infof("n=%d", 2) }, "INFO", "n=2"},
+		{"Warn", func(l Logger) { l.Warn("careful") }, "WARN", "careful\n"},
+		{"Warnf", func(l Logger) { l.Warnf("slow: %s", "query") }, "WARN", "slow: query"},
+		{"Error", func(l Logger) { l.Error("boom") }, "ERROR", "boom\n"},
+		{"Errorf", func(l Logger) { l.Errorf("boom: %v", "bad") }, "ERROR", "boom: bad"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			c.call(newSlogTestLogger(&buf))
+
+			record := decodeLastLine(t, &buf)
+			if record["level"] != c.wantLevel {
+				t.Errorf("level: got %v, want %s", record["level"], c.wantLevel)
+			}
+			if record["msg"] != c.wantMsg {
+				t.Errorf("msg: got %q, want %q", record["msg"], c.wantMsg)
+			}
+		})
+	}
+}
+
+// ctxRecorder 包一层 slog.Handler，记下 Handle 收到的 ctx。
+type ctxRecorder struct {
+	slog.Handler
+	got context.Context
+}
+
+func (h *ctxRecorder) Handle(ctx context.Context, r slog.Record) error {
+	h.got = ctx
+	return h.Handler.Handle(ctx, r)
+}
+
+// TestSlogLoggerWithContextCarriesAttrs 验证 WithContext 绑定的 ctx 会原样
+// 传给底层 slog.Logger 的 *Context 方法，这样 ctx 里通过 slog Handler 解析出的
+// 属性（比如 trace id）才能出现在日志里。
+func TestSlogLoggerWithContextCarriesAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	rec := &ctxRecorder{Handler: slog.NewJSONHandler(&buf, nil)}
+	base := NewSlogLogger(slog.New(rec))
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "trace-123")
+	bound := base.WithContext(ctx)
+	bound.Info("with ctx")
+
+	if buf.Len() == 0 {
+		t.Fatal("expected WithContext logger to still write output")
+	}
+	if rec.got == nil || rec.got.Value(ctxKey{}) != "trace-123" {
+		t.Fatal("expected the ctx bound by WithContext to reach the slog Handler")
+	}
+	record := decodeLastLine(t, &buf)
+	if record["msg"] != "with ctx\n" {
+		t.Fatalf("msg: got %q, want %q", record["msg"], "with ctx\n")
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"database/sql"
+	"geeorm/dialect"
+	"testing"
+)
+
+var TestDial, _ = dialect.GetDialect("sqlite3")
+
+type User struct {
+	Name string
+	Age  int
+}
+
+// Profile 用来验证 sql.NullXxx 字段会被解析成可以为 NULL 的列。
+type Profile struct {
+	Name string
+	Bio  sql.NullString
+}
+
+// Base 模拟一个被其他结构体内嵌的基础字段集合。
+type Base struct {
+	ID int
+}
+
+// Admin 内嵌了 Base，解析时应当把 Base 的导出字段展开到 Admin 自己的列里。
+type Admin struct {
+	Base
+	Name string
+}
+
+func TestParse(t *testing.T) {
+	schema := Parse(&User{}, TestDial)
+	if schema.Name != "User" {
+		t.Fatal("failed to parse table name")
+	}
+	if len(schema.Fields) != 2 || len(schema.FieldNames) != 2 {
+		t.Fatal("failed to parse exported fields")
+	}
+	if schema.GetField("Name").Type != "text" {
+		t.Fatal("failed to parse Name field type")
+	}
+}
+
+// TestParse_Embedded 验证匿名内嵌结构体的导出字段会被展开为当前表的列。
+func TestParse_Embedded(t *testing.T) {
+	schema := Parse(&Admin{}, TestDial)
+	if len(schema.FieldNames) != 2 {
+		t.Fatal("failed to flatten embedded struct fields")
+	}
+	if schema.GetField("ID") == nil {
+		t.Fatal("failed to promote embedded field ID")
+	}
+}
+
+// TestParse_Nullable 验证 sql.NullString 字段被标记为 Nullable，且类型映射到 "text"。
+func TestParse_Nullable(t *testing.T) {
+	schema := Parse(&Profile{}, TestDial)
+	bio := schema.GetField("Bio")
+	if bio == nil || !bio.Nullable || bio.Type != "text" {
+		t.Fatal("failed to treat sql.NullString as a nullable text column")
+	}
+	if schema.GetField("Name").Nullable {
+		t.Fatal("plain string field should not be nullable")
+	}
+}
+
+// TestParse_PointerReceiver 验证 Parse 接受的是结构体指针，而不是结构体值本身。
+func TestParse_PointerReceiver(t *testing.T) {
+	u := &User{Name: "Tom", Age: 18}
+	schema := Parse(u, TestDial)
+	if schema.Model.(*User) != u {
+		t.Fatal("failed to keep the original pointer as Model")
+	}
+}
@@ -0,0 +1,83 @@
+package session
+
+import (
+	"geeorm/schema"
+	"reflect"
+	"testing"
+)
+
+type scanTarget struct {
+	Name string
+	Age  int64
+}
+
+type tagHolder struct {
+	Tag scanTag
+}
+
+type scanTag struct {
+	Value string
+}
+
+func newFieldScanner(structPtr interface{}, field string) fieldScanner {
+	return fieldScanner{field: reflect.ValueOf(structPtr).Elem().FieldByName(field)}
+}
+
+// TestFieldScannerScanNull 验证列值为 NULL（src == nil）时，字段被置成对应类型的零值。
+func TestFieldScannerScanNull(t *testing.T) {
+	target := &scanTarget{Name: "Tom", Age: 18}
+	if err := newFieldScanner(target, "Name").Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	if target.Name != "" {
+		t.Fatalf("got %q, want zero value", target.Name)
+	}
+}
+
+// TestFieldScannerScanAssignable 验证驱动给出的值类型和字段类型完全一致时直接赋值。
+func TestFieldScannerScanAssignable(t *testing.T) {
+	target := &scanTarget{}
+	if err := newFieldScanner(target, "Name").Scan("Sam"); err != nil {
+		t.Fatal(err)
+	}
+	if target.Name != "Sam" {
+		t.Fatalf("got %q, want Sam", target.Name)
+	}
+}
+
+// TestFieldScannerScanConvertible 验证类型不直接相等但可以 Convert 时（比如驱动给了
+// float64，字段是 int64）会走 ConvertibleTo 分支，而不是直接报错。
+func TestFieldScannerScanConvertible(t *testing.T) {
+	target := &scanTarget{}
+	if err := newFieldScanner(target, "Age").Scan(float64(20)); err != nil {
+		t.Fatal(err)
+	}
+	if target.Age != 20 {
+		t.Fatalf("got %d, want 20", target.Age)
+	}
+}
+
+// TestFieldScannerScanUsesConverter 验证注册过 schema.RegisterConverter 的类型
+// 优先走转换函数，而不是落到 AssignableTo/ConvertibleTo 的兜底逻辑。
+func TestFieldScannerScanUsesConverter(t *testing.T) {
+	schema.RegisterConverter(reflect.TypeOf(scanTag{}), func(src interface{}) (interface{}, error) {
+		return scanTag{Value: src.(string)}, nil
+	})
+
+	target := &tagHolder{}
+	if err := newFieldScanner(target, "Tag").Scan("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if target.Tag.Value != "hello" {
+		t.Fatalf("got %+v, want Value=hello", target.Tag)
+	}
+}
+
+// TestFieldScannerScanTypeMismatch 验证既没有注册 Converter、又既不能 Assign
+// 也不能 Convert 的类型会报错，而不是 panic 或者悄悄写入一个错误的值。
+func TestFieldScannerScanTypeMismatch(t *testing.T) {
+	target := &scanTarget{}
+	if err := newFieldScanner(target, "Age").Scan([]byte{1, 2, 3}); err == nil {
+		t.Fatal("expected an error for an unconvertible type")
+	}
+}
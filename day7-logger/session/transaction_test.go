@@ -0,0 +1,22 @@
+package session
+
+import "testing"
+
+// TestSavepointName 验证每一层嵌套得到一个按 txDepth 区分的确定性 SAVEPOINT 名字，
+// 这样 Begin/Commit/Rollback 在同一个 Session 上嵌套调用时不会撞名。
+func TestSavepointName(t *testing.T) {
+	cases := []struct {
+		txDepth int
+		want    string
+	}{
+		{0, "sp_0"},
+		{1, "sp_1"},
+		{3, "sp_3"},
+	}
+	for _, c := range cases {
+		s := &Session{txDepth: c.txDepth}
+		if got := s.savepointName(); got != c.want {
+			t.Errorf("txDepth=%d: got %s, want %s", c.txDepth, got, c.want)
+		}
+	}
+}